@@ -0,0 +1,109 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	ackleyMin             = -30.0
+	ackleyMax             = 30.0
+	ackleyA               = 20.0
+	ackleyB               = 0.2
+	ackleyC               = 2 * math.Pi
+	ackleySolvedThreshold = 1e-6
+	ackleyMutationStdDev  = 1.0
+)
+
+// Ackley optimizes the Ackley function, a classic multimodal benchmark
+// for continuous optimizers, over [-30, 30]^Dimensions. The global
+// minimum (function value 0) sits at the origin; GA fitness is the
+// negated function value so that higher fitness is better.
+type Ackley struct {
+	Dimensions int
+}
+
+func NewAckley(dimensions int) *Ackley {
+	return &Ackley{Dimensions: dimensions}
+}
+
+func (p *Ackley) Name() string {
+	return "ackley"
+}
+
+func (p *Ackley) NewIndividual(rng *rand.Rand) Individual {
+	ind := make(Individual, p.Dimensions)
+	for i := range ind {
+		ind[i] = ackleyMin + rng.Float64()*(ackleyMax-ackleyMin)
+	}
+	return ind
+}
+
+func ackleyValue(ind Individual) float64 {
+	n := float64(len(ind))
+
+	sumSq := 0.0
+	sumCos := 0.0
+	for _, x := range ind {
+		sumSq += x * x
+		sumCos += math.Cos(ackleyC * x)
+	}
+
+	term1 := -ackleyA * math.Exp(-ackleyB*math.Sqrt(sumSq/n))
+	term2 := -math.Exp(sumCos / n)
+
+	return term1 + term2 + ackleyA + math.E
+}
+
+func (p *Ackley) Fitness(ind Individual) float64 {
+	return -ackleyValue(ind)
+}
+
+func (p *Ackley) IsSolved(fitness float64) bool {
+	return -fitness <= ackleySolvedThreshold
+}
+
+// Crossover performs arithmetic (blend) crossover: each child gene is
+// a random weighted average of the corresponding parent genes, which
+// keeps offspring within the bounds of a real-valued search space.
+func (p *Ackley) Crossover(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual) {
+	if rng.Float64() > CrossoverRate {
+		child1 := make(Individual, len(parent1))
+		child2 := make(Individual, len(parent2))
+		copy(child1, parent1)
+		copy(child2, parent2)
+		return child1, child2
+	}
+
+	child1 := make(Individual, p.Dimensions)
+	child2 := make(Individual, p.Dimensions)
+
+	for i := 0; i < p.Dimensions; i++ {
+		alpha := rng.Float64()
+		child1[i] = alpha*parent1[i] + (1-alpha)*parent2[i]
+		child2[i] = alpha*parent2[i] + (1-alpha)*parent1[i]
+	}
+
+	return child1, child2
+}
+
+// Mutate applies Gaussian perturbation to each gene independently at
+// MutationRate, clamped back into [-30, 30].
+func (p *Ackley) Mutate(rng *rand.Rand, ind Individual) Individual {
+	mutated := make(Individual, len(ind))
+	copy(mutated, ind)
+
+	for i := range mutated {
+		if rng.Float64() < MutationRate {
+			mutated[i] += rng.NormFloat64() * ackleyMutationStdDev
+			if mutated[i] < ackleyMin {
+				mutated[i] = ackleyMin
+			}
+			if mutated[i] > ackleyMax {
+				mutated[i] = ackleyMax
+			}
+		}
+	}
+
+	return mutated
+}
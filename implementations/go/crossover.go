@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// BitCrossover recombines two bitstring parents into two children.
+// It only makes sense for a fixed-length bitstring encoding like
+// OneMax; permutation and real-valued problems use crossover tied to
+// their own encoding instead (see NQueens and Ackley).
+type BitCrossover interface {
+	Name() string
+	Cross(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual)
+}
+
+func copyParents(parent1, parent2 Individual) (Individual, Individual) {
+	return cloneIndividual(parent1), cloneIndividual(parent2)
+}
+
+// SinglePointBitCrossover swaps everything after one random cut point.
+type SinglePointBitCrossover struct{}
+
+func (c *SinglePointBitCrossover) Name() string {
+	return "single-point"
+}
+
+func (c *SinglePointBitCrossover) Cross(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual) {
+	if rng.Float64() > CrossoverRate {
+		return copyParents(parent1, parent2)
+	}
+
+	n := len(parent1)
+	point := rng.Intn(n-1) + 1
+
+	child1 := make(Individual, n)
+	child2 := make(Individual, n)
+
+	copy(child1[:point], parent1[:point])
+	copy(child1[point:], parent2[point:])
+
+	copy(child2[:point], parent2[:point])
+	copy(child2[point:], parent1[point:])
+
+	return child1, child2
+}
+
+// TwoPointBitCrossover swaps the segment between two random cut
+// points and leaves the rest untouched.
+type TwoPointBitCrossover struct{}
+
+func (c *TwoPointBitCrossover) Name() string {
+	return "two-point"
+}
+
+func (c *TwoPointBitCrossover) Cross(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual) {
+	if rng.Float64() > CrossoverRate {
+		return copyParents(parent1, parent2)
+	}
+
+	n := len(parent1)
+	a := rng.Intn(n)
+	b := rng.Intn(n)
+	if a > b {
+		a, b = b, a
+	}
+
+	child1 := cloneIndividual(parent1)
+	child2 := cloneIndividual(parent2)
+
+	for i := a; i <= b; i++ {
+		child1[i], child2[i] = child2[i], child1[i]
+	}
+
+	return child1, child2
+}
+
+// UniformBitCrossover decides each gene independently: a coin flip
+// per position picks which parent contributes that gene to child1,
+// with child2 taking the opposite parent.
+type UniformBitCrossover struct{}
+
+func (c *UniformBitCrossover) Name() string {
+	return "uniform"
+}
+
+func (c *UniformBitCrossover) Cross(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual) {
+	if rng.Float64() > CrossoverRate {
+		return copyParents(parent1, parent2)
+	}
+
+	n := len(parent1)
+	child1 := make(Individual, n)
+	child2 := make(Individual, n)
+
+	for i := 0; i < n; i++ {
+		if rng.Float64() < 0.5 {
+			child1[i], child2[i] = parent1[i], parent2[i]
+		} else {
+			child1[i], child2[i] = parent2[i], parent1[i]
+		}
+	}
+
+	return child1, child2
+}
+
+func newBitCrossover(name string) (BitCrossover, error) {
+	switch name {
+	case "single-point":
+		return &SinglePointBitCrossover{}, nil
+	case "two-point":
+		return &TwoPointBitCrossover{}, nil
+	case "uniform":
+		return &UniformBitCrossover{}, nil
+	default:
+		return nil, fmt.Errorf("unknown crossover strategy %q (want single-point, two-point, or uniform)", name)
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const (
+	DEDefaultF  = 0.5
+	DEDefaultCR = 0.9
+)
+
+// DEStrategy selects how a DE mutant vector is built from the
+// population each generation.
+type DEStrategy int
+
+const (
+	DERand1 DEStrategy = iota
+	DEBest1
+	DECurrentToBest1
+	DERand2
+	DEBest2
+)
+
+func (s DEStrategy) String() string {
+	switch s {
+	case DERand1:
+		return "rand/1"
+	case DEBest1:
+		return "best/1"
+	case DECurrentToBest1:
+		return "current-to-best/1"
+	case DERand2:
+		return "rand/2"
+	case DEBest2:
+		return "best/2"
+	default:
+		return "unknown"
+	}
+}
+
+func parseDEStrategy(name string) (DEStrategy, error) {
+	switch name {
+	case "rand/1":
+		return DERand1, nil
+	case "best/1":
+		return DEBest1, nil
+	case "current-to-best/1":
+		return DECurrentToBest1, nil
+	case "rand/2":
+		return DERand2, nil
+	case "best/2":
+		return DEBest2, nil
+	default:
+		return 0, fmt.Errorf("unknown DE strategy %q (want rand/1, best/1, current-to-best/1, rand/2, or best/2)", name)
+	}
+}
+
+// randDistinctIndices returns count indices in [0, n) that are
+// pairwise distinct and none of which appear in exclude, so mutation
+// never reuses the target vector or duplicates a donor.
+func randDistinctIndices(rng *rand.Rand, n, count int, exclude ...int) []int {
+	excluded := make(map[int]bool, len(exclude))
+	for _, e := range exclude {
+		excluded[e] = true
+	}
+
+	chosen := make(map[int]bool, count)
+	indices := make([]int, 0, count)
+	for len(indices) < count {
+		idx := rng.Intn(n)
+		if excluded[idx] || chosen[idx] {
+			continue
+		}
+		chosen[idx] = true
+		indices = append(indices, idx)
+	}
+
+	return indices
+}
+
+func deDiff(base, b, c Individual, f float64) Individual {
+	result := make(Individual, len(base))
+	for k := range result {
+		result[k] = base[k] + f*(b[k]-c[k])
+	}
+	return result
+}
+
+// deMutant builds the mutant vector v for target index i according to
+// strategy, drawing donors from population at random (excluding i and,
+// where the strategy calls for it, bestIdx).
+func deMutant(rng *rand.Rand, strategy DEStrategy, population []Individual, bestIdx, i int, f float64) Individual {
+	n := len(population)
+
+	switch strategy {
+	case DERand1:
+		idx := randDistinctIndices(rng, n, 3, i)
+		return deDiff(population[idx[0]], population[idx[1]], population[idx[2]], f)
+
+	case DEBest1:
+		idx := randDistinctIndices(rng, n, 2, i, bestIdx)
+		return deDiff(population[bestIdx], population[idx[0]], population[idx[1]], f)
+
+	case DECurrentToBest1:
+		idx := randDistinctIndices(rng, n, 2, i, bestIdx)
+		target, best, b, c := population[i], population[bestIdx], population[idx[0]], population[idx[1]]
+		mutant := make(Individual, len(target))
+		for k := range mutant {
+			mutant[k] = target[k] + f*(best[k]-target[k]) + f*(b[k]-c[k])
+		}
+		return mutant
+
+	case DERand2:
+		idx := randDistinctIndices(rng, n, 5, i)
+		a, b, c, d, e := population[idx[0]], population[idx[1]], population[idx[2]], population[idx[3]], population[idx[4]]
+		mutant := make(Individual, len(a))
+		for k := range mutant {
+			mutant[k] = a[k] + f*(b[k]-c[k]) + f*(d[k]-e[k])
+		}
+		return mutant
+
+	case DEBest2:
+		idx := randDistinctIndices(rng, n, 4, i, bestIdx)
+		best, b, c, d, e := population[bestIdx], population[idx[0]], population[idx[1]], population[idx[2]], population[idx[3]]
+		mutant := make(Individual, len(best))
+		for k := range mutant {
+			mutant[k] = best[k] + f*(b[k]-c[k]) + f*(d[k]-e[k])
+		}
+		return mutant
+
+	default:
+		panic(fmt.Sprintf("deMutant: unhandled strategy %v", strategy))
+	}
+}
+
+// deCrossover performs binomial crossover between the target vector
+// and the mutant, guaranteeing at least one coordinate (jrand) is
+// taken from the mutant so the trial always differs from the target.
+func deCrossover(rng *rand.Rand, target, mutant Individual, cr float64) Individual {
+	n := len(target)
+	trial := make(Individual, n)
+	jrand := rng.Intn(n)
+
+	for k := 0; k < n; k++ {
+		if k == jrand || rng.Float64() < cr {
+			trial[k] = mutant[k]
+		} else {
+			trial[k] = target[k]
+		}
+	}
+
+	return trial
+}
+
+func clampToAckleyBounds(ind Individual) Individual {
+	clamped := make(Individual, len(ind))
+	for k, v := range ind {
+		switch {
+		case v < ackleyMin:
+			clamped[k] = ackleyMin
+		case v > ackleyMax:
+			clamped[k] = ackleyMax
+		default:
+			clamped[k] = v
+		}
+	}
+	return clamped
+}
+
+// runDE runs Differential Evolution (DE/<strategy>/bin) against problem
+// until it reports the population solved or MaxGenerations elapses,
+// returning a RunResult so both share the same benchmark harness as
+// runGA and runIslandGA.
+func runDE(problem *Ackley, strategy DEStrategy, f, cr float64) RunResult {
+	runStart := time.Now()
+	population := make([]Individual, PopulationSize)
+	fitnesses := make([]float64, PopulationSize)
+
+	rng := rand.New(rand.NewSource(rand.Int63()))
+
+	bestIdx := 0
+	for i := range population {
+		population[i] = problem.NewIndividual(rng)
+		fitnesses[i] = problem.Fitness(population[i])
+		if fitnesses[i] > fitnesses[bestIdx] {
+			bestIdx = i
+		}
+	}
+
+	history := make([]GenerationStat, 0, MaxGenerations)
+
+	for generation := 0; generation < MaxGenerations; generation++ {
+		genStart := time.Now()
+		history = append(history, GenerationStat{
+			Generation: generation,
+			Best:       fitnesses[bestIdx],
+			Mean:       meanOf(fitnesses),
+			Worst:      worstFitnessOf(fitnesses),
+			Diversity:  sampledDiversity(rng, population),
+			WallTime:   time.Since(genStart),
+		})
+
+		if problem.IsSolved(fitnesses[bestIdx]) {
+			return RunResult{Generations: generation, BestFitness: fitnesses[bestIdx], WallTime: time.Since(runStart), History: history}
+		}
+
+		for i := range population {
+			mutant := deMutant(rng, strategy, population, bestIdx, i, f)
+			trial := clampToAckleyBounds(deCrossover(rng, population[i], mutant, cr))
+			trialFitness := problem.Fitness(trial)
+
+			if trialFitness > fitnesses[i] {
+				population[i] = trial
+				fitnesses[i] = trialFitness
+				if trialFitness > fitnesses[bestIdx] {
+					bestIdx = i
+				}
+			}
+		}
+		history[len(history)-1].WallTime = time.Since(genStart)
+	}
+
+	return RunResult{Generations: MaxGenerations, BestFitness: fitnesses[bestIdx], WallTime: time.Since(runStart), History: history}
+}
+
+func runDECommand(args []string) {
+	fs := flag.NewFlagSet("de", flag.ExitOnError)
+	strategyName := fs.String("strategy", "rand/1", "DE mutation strategy: rand/1, best/1, current-to-best/1, rand/2, or best/2")
+	f := fs.Float64("f", DEDefaultF, "differential weight F")
+	cr := fs.Float64("cr", DEDefaultCR, "crossover rate CR")
+	outputPath := fs.String("output", "", "path to write the full run log to (omit to skip writing one)")
+	outputFormat := fs.String("format", "csv", "run log format when --output is set: csv or json")
+	fs.Parse(args)
+
+	strategy, err := parseDEStrategy(*strategyName)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	problem := NewAckley(ChromosomeLength)
+	label := fmt.Sprintf("de-%s", strategy)
+
+	rand.Seed(time.Now().UnixNano())
+	runTests(label, func() RunResult { return runDE(problem, strategy, *f, *cr) }, 25, *outputPath, *outputFormat)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// islandState holds one island's subpopulation, its last-evaluated
+// fitnesses, and its own independently seeded RNG.
+type islandState struct {
+	population []Individual
+	fitnesses  []float64
+	rng        *rand.Rand
+}
+
+func newIslandState(problem Problem) *islandState {
+	rng := rand.New(rand.NewSource(rand.Int63()))
+	population := make([]Individual, PopulationSize)
+	for i := range population {
+		population[i] = problem.NewIndividual(rng)
+	}
+	return &islandState{population: population, rng: rng}
+}
+
+func (st *islandState) evaluate(problem Problem) float64 {
+	st.fitnesses = make([]float64, len(st.population))
+	computeFitnessRange(problem, st.population, st.fitnesses, 0, len(st.population))
+	return bestFitnessOf(st.fitnesses)
+}
+
+func (st *islandState) advance(problem Problem, selection Selection) {
+	newPopulation := make([]Individual, PopulationSize)
+	buildGenerationRange(problem, selection, st.rng, st.population, st.fitnesses, newPopulation, 0, ceilDiv(PopulationSize, 2))
+	st.population = newPopulation
+}
+
+// topIndividuals returns clones of the migrationSize fittest
+// individuals in an island, fittest first.
+func topIndividuals(population []Individual, fitnesses []float64, migrationSize int) []Individual {
+	order := make([]int, len(population))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return fitnesses[order[a]] > fitnesses[order[b]]
+	})
+
+	migrants := make([]Individual, migrationSize)
+	for i := 0; i < migrationSize; i++ {
+		migrants[i] = cloneIndividual(population[order[i]])
+	}
+	return migrants
+}
+
+// replaceWorst overwrites the len(migrants) worst individuals in
+// population with migrants. The caller is responsible for refreshing
+// fitnesses afterward, since the migrants' fitness values came from a
+// different island's landscape bookkeeping.
+func replaceWorst(population []Individual, fitnesses []float64, migrants []Individual) {
+	order := make([]int, len(population))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return fitnesses[order[a]] < fitnesses[order[b]]
+	})
+
+	for i, migrant := range migrants {
+		population[order[i]] = migrant
+	}
+}
+
+// migrate copies the top migrationSize individuals from each island to
+// replace the worst migrationSize individuals of the next island in
+// the ring (island i's migrants land in island i+1 mod len(islands)).
+// Migrants are computed from a snapshot of every island before any
+// replacement happens, so no island both sends and receives a mutated
+// population mid-exchange.
+func migrate(islands []*islandState, migrationSize int) {
+	if migrationSize <= 0 || len(islands) < 2 {
+		return
+	}
+
+	outgoing := make([][]Individual, len(islands))
+	for i, st := range islands {
+		outgoing[i] = topIndividuals(st.population, st.fitnesses, migrationSize)
+	}
+
+	for i, st := range islands {
+		source := outgoing[(i-1+len(islands))%len(islands)]
+		replaceWorst(st.population, st.fitnesses, source)
+	}
+}
+
+// pooledPopulation concatenates every island's population and
+// fitnesses into a single pair of slices, so a generation-level
+// GenerationStat can be computed the same way runGA computes one for
+// its single panmictic population.
+func pooledPopulation(states []*islandState) ([]Individual, []float64) {
+	total := 0
+	for _, st := range states {
+		total += len(st.population)
+	}
+
+	population := make([]Individual, 0, total)
+	fitnesses := make([]float64, 0, total)
+	for _, st := range states {
+		population = append(population, st.population...)
+		fitnesses = append(fitnesses, st.fitnesses...)
+	}
+	return population, fitnesses
+}
+
+// runIslandGA runs the island model: islands independent subpopulations,
+// each evolving in its own goroutine with its own RNG, exchanging
+// migrants in a ring topology every migrationInterval generations.
+// It terminates, like runGA, as soon as any island solves the problem
+// or MaxGenerations elapses, and returns a RunResult holding the
+// generation and best fitness seen across all islands. Its History is
+// computed by pooling every island's population into one for the
+// generation, so Best/Mean/Worst/Diversity read the same way runGA's
+// do, just over the combined islands rather than a single population.
+func runIslandGA(problem Problem, selection Selection, islands, migrationInterval, migrationSize int) RunResult {
+	runStart := time.Now()
+	states := make([]*islandState, islands)
+	for i := range states {
+		states[i] = newIslandState(problem)
+	}
+	history := make([]GenerationStat, 0, MaxGenerations)
+	diversityRng := rand.New(rand.NewSource(rand.Int63()))
+
+	for generation := 0; generation < MaxGenerations; generation++ {
+		genStart := time.Now()
+		bestFitnesses := make([]float64, islands)
+
+		var wg sync.WaitGroup
+		for i, st := range states {
+			wg.Add(1)
+			go func(i int, st *islandState) {
+				defer wg.Done()
+				bestFitnesses[i] = st.evaluate(problem)
+			}(i, st)
+		}
+		wg.Wait()
+
+		overallBest := bestFitnessOf(bestFitnesses)
+		pooledIndividuals, pooledFitnesses := pooledPopulation(states)
+		history = append(history, GenerationStat{
+			Generation: generation,
+			Best:       overallBest,
+			Mean:       meanOf(pooledFitnesses),
+			Worst:      worstFitnessOf(pooledFitnesses),
+			Diversity:  sampledDiversity(diversityRng, pooledIndividuals),
+			WallTime:   time.Since(genStart),
+		})
+
+		for _, best := range bestFitnesses {
+			if problem.IsSolved(best) {
+				return RunResult{Generations: generation, BestFitness: overallBest, WallTime: time.Since(runStart), History: history}
+			}
+		}
+
+		if migrationInterval > 0 && generation > 0 && generation%migrationInterval == 0 {
+			migrate(states, migrationSize)
+			for _, st := range states {
+				computeFitnessRange(problem, st.population, st.fitnesses, 0, len(st.population))
+			}
+		}
+
+		wg = sync.WaitGroup{}
+		for _, st := range states {
+			wg.Add(1)
+			go func(st *islandState) {
+				defer wg.Done()
+				st.advance(problem, selection)
+			}(st)
+		}
+		wg.Wait()
+		history[len(history)-1].WallTime = time.Since(genStart)
+	}
+
+	bestFitnesses := make([]float64, islands)
+	for i, st := range states {
+		bestFitnesses[i] = st.evaluate(problem)
+	}
+	return RunResult{Generations: MaxGenerations, BestFitness: bestFitnessOf(bestFitnesses), WallTime: time.Since(runStart), History: history}
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	PopulationSize   = 100
+	ChromosomeLength = 100
+	MaxGenerations   = 500
+	CrossoverRate    = 0.8
+	MutationRate     = 0.01
+)
+
+// ceilDiv splits n items into the smallest chunk size that spreads
+// them across at most workers chunks.
+func ceilDiv(n, workers int) int {
+	return (n + workers - 1) / workers
+}
+
+// forEachChunk runs fn over [0, n) split into at most workers
+// goroutines, each covering a contiguous [start, end) range. Each
+// goroutine gets its own independently seeded *rand.Rand so the GA's
+// random draws don't contend on the global math/rand source.
+func forEachChunk(n, workers int, fn func(rng *rand.Rand, start, end int)) {
+	chunkSize := ceilDiv(n, workers)
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunkSize {
+		end := min(start+chunkSize, n)
+		wg.Add(1)
+		go func(rng *rand.Rand, start, end int) {
+			defer wg.Done()
+			fn(rng, start, end)
+		}(rand.New(rand.NewSource(rand.Int63())), start, end)
+	}
+	wg.Wait()
+}
+
+func createPopulation(problem Problem, workers int) []Individual {
+	population := make([]Individual, PopulationSize)
+
+	forEachChunk(PopulationSize, workers, func(rng *rand.Rand, start, end int) {
+		for i := start; i < end; i++ {
+			population[i] = problem.NewIndividual(rng)
+		}
+	})
+
+	return population
+}
+
+// buildGenerationRange fills newPopulation's pair slots [startPair,
+// endPair) via selection, crossover, and mutation. It's the unit of
+// work chunked across workers in createNewGeneration, and is also
+// called directly (one pair range covering the whole population) by
+// each island's single-goroutine loop in runIslandGA.
+func buildGenerationRange(problem Problem, selection Selection, rng *rand.Rand, population []Individual, fitnesses []float64, newPopulation []Individual, startPair, endPair int) {
+	for pair := startPair; pair < endPair; pair++ {
+		parent1 := selection.Select(rng, population, fitnesses)
+		parent2 := selection.Select(rng, population, fitnesses)
+
+		child1, child2 := problem.Crossover(rng, parent1, parent2)
+
+		child1 = problem.Mutate(rng, child1)
+		child2 = problem.Mutate(rng, child2)
+
+		idx := pair * 2
+		newPopulation[idx] = child1
+		if idx+1 < PopulationSize {
+			newPopulation[idx+1] = child2
+		}
+	}
+}
+
+// createNewGeneration fills PopulationSize slots in pairs, each pair
+// produced by one selection/crossover/mutation round. Pairs are
+// chunked across workers goroutines, each writing to disjoint slots of
+// newPopulation so no synchronization is needed beyond the join.
+func createNewGeneration(problem Problem, selection Selection, population []Individual, fitnesses []float64, workers int) []Individual {
+	newPopulation := make([]Individual, PopulationSize)
+	pairCount := ceilDiv(PopulationSize, 2)
+
+	forEachChunk(pairCount, workers, func(rng *rand.Rand, start, end int) {
+		buildGenerationRange(problem, selection, rng, population, fitnesses, newPopulation, start, end)
+	})
+
+	return newPopulation
+}
+
+// computeFitnessRange fills fitnesses[start:end] from population. It's
+// the unit of work chunked across workers in evaluatePopulation, and
+// is also called directly (the whole population range) by each
+// island's single-goroutine loop in runIslandGA.
+func computeFitnessRange(problem Problem, population []Individual, fitnesses []float64, start, end int) {
+	for i := start; i < end; i++ {
+		fitnesses[i] = problem.Fitness(population[i])
+	}
+}
+
+func bestFitnessOf(fitnesses []float64) float64 {
+	best := fitnesses[0]
+	for _, f := range fitnesses[1:] {
+		if f > best {
+			best = f
+		}
+	}
+	return best
+}
+
+func evaluatePopulation(problem Problem, population []Individual, workers int) ([]float64, float64) {
+	fitnesses := make([]float64, len(population))
+
+	forEachChunk(len(population), workers, func(_ *rand.Rand, start, end int) {
+		computeFitnessRange(problem, population, fitnesses, start, end)
+	})
+
+	return fitnesses, bestFitnessOf(fitnesses)
+}
+
+// runGA runs the panmictic GA to completion, recording a GenerationStat
+// for every generation it visits so the caller can inspect convergence
+// speed and population diversity, not just the final result.
+func runGA(problem Problem, selection Selection, workers int) RunResult {
+	runStart := time.Now()
+	population := createPopulation(problem, workers)
+	history := make([]GenerationStat, 0, MaxGenerations)
+	diversityRng := rand.New(rand.NewSource(rand.Int63()))
+
+	for generation := 0; generation < MaxGenerations; generation++ {
+		genStart := time.Now()
+		fitnesses, bestFitness := evaluatePopulation(problem, population, workers)
+
+		history = append(history, GenerationStat{
+			Generation: generation,
+			Best:       bestFitness,
+			Mean:       meanOf(fitnesses),
+			Worst:      worstFitnessOf(fitnesses),
+			Diversity:  sampledDiversity(diversityRng, population),
+			WallTime:   time.Since(genStart),
+		})
+
+		if problem.IsSolved(bestFitness) {
+			return RunResult{Generations: generation, BestFitness: bestFitness, WallTime: time.Since(runStart), History: history}
+		}
+
+		population = createNewGeneration(problem, selection, population, fitnesses, workers)
+		history[len(history)-1].WallTime = time.Since(genStart)
+	}
+
+	_, bestFitness := evaluatePopulation(problem, population, workers)
+	return RunResult{Generations: MaxGenerations, BestFitness: bestFitness, WallTime: time.Since(runStart), History: history}
+}
+
+// newProblem builds the named problem. crossover and mutation only
+// affect onemax, since nqueens and ackley use operators tied to their
+// own permutation/real-valued encodings.
+func newProblem(name string, crossover BitCrossover, mutation BitMutation) (Problem, error) {
+	switch name {
+	case "onemax":
+		return NewOneMax(ChromosomeLength, crossover, mutation), nil
+	case "nqueens":
+		return NewNQueens(ChromosomeLength), nil
+	case "ackley":
+		return NewAckley(ChromosomeLength), nil
+	default:
+		return nil, fmt.Errorf("unknown problem %q (want onemax, nqueens, or ackley)", name)
+	}
+}
+
+// perWorkerOutputPath inserts a "-workersN" suffix before path's
+// extension (or at its end, if it has none), so --sweep-workers runs
+// each write their own run log instead of repeatedly truncating one
+// shared file down to just the last worker count's history.
+func perWorkerOutputPath(path string, workers int) string {
+	if path == "" {
+		return ""
+	}
+
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	return fmt.Sprintf("%s-workers%d%s", base, workers, ext)
+}
+
+// runTests drives numRuns benchmark runs of run (runGA, runDE, or
+// runIslandGA wrapped up with whichever problem/parameters the caller
+// chose), prints a CSV timing row ("go", label, then each run's time
+// in milliseconds) plus a mean/stdev/min/max summary, and — when outputPath is
+// set — writes the full per-run, per-generation Stats log to disk.
+func runTests(label string, run func() RunResult, numRuns int, outputPath, outputFormat string) []RunResult {
+	fmt.Printf("Go %s Performance Test\n", label)
+	fmt.Printf("Running %d tests...\n", numRuns)
+
+	runs := make([]RunResult, numRuns)
+
+	for i := 0; i < numRuns; i++ {
+		runs[i] = run()
+		fmt.Printf("\rRun %d: %.3f ms", i+1, runResultMs(runs[i]))
+	}
+
+	fmt.Printf("\nCompleted %d runs\n", numRuns)
+
+	fmt.Printf("go,%s,", label)
+	for i, r := range runs {
+		if i > 0 {
+			fmt.Print(",")
+		}
+		fmt.Printf("%.6f", runResultMs(r))
+	}
+	fmt.Println()
+
+	summary := summarizeRuns(label, runs)
+	fmt.Printf("mean=%.3fms stdev=%.3fms min=%.3fms max=%.3fms\n", summary.MeanMs, summary.StdDevMs, summary.MinMs, summary.MaxMs)
+
+	if outputPath != "" {
+		if err := writeStatsFile(outputPath, outputFormat, summary); err != nil {
+			fmt.Printf("failed to write stats to %s: %v\n", outputPath, err)
+		} else {
+			fmt.Printf("Wrote run log to %s (%s)\n", outputPath, outputFormat)
+		}
+	}
+
+	return runs
+}
+
+func runGACommand(args []string) {
+	fs := flag.NewFlagSet("ga", flag.ExitOnError)
+	problemName := fs.String("problem", "onemax", "problem to benchmark: onemax, nqueens, or ackley")
+	workers := fs.Int("workers", runtime.NumCPU(), "number of worker goroutines for fitness evaluation and generation construction")
+	sweepWorkers := fs.Bool("sweep-workers", false, "benchmark worker counts 1..NumCPU instead of a single --workers value")
+	selectionName := fs.String("selection", "tournament", "selection strategy: tournament, roulette, or rank")
+	crossoverName := fs.String("crossover", "single-point", "crossover strategy (onemax only): single-point, two-point, or uniform")
+	mutationName := fs.String("mutation", "bernoulli", "mutation strategy (onemax only): bernoulli or reservoir")
+	islands := fs.Int("islands", 1, "number of islands; >1 switches to the island model with ring-topology migration")
+	migrationInterval := fs.Int("migration-interval", 10, "generations between migrations in island mode")
+	migrationSize := fs.Int("migration-size", 5, "individuals migrated to the next island (ring topology) in island mode")
+	outputPath := fs.String("output", "", "path to write the full run log to (omit to skip writing one)")
+	outputFormat := fs.String("format", "csv", "run log format when --output is set: csv or json")
+	fs.Parse(args)
+
+	if *workers < 1 {
+		fmt.Printf("--workers must be >= 1, got %d\n", *workers)
+		return
+	}
+
+	if *migrationSize > PopulationSize {
+		fmt.Printf("--migration-size must be <= population size (%d), got %d\n", PopulationSize, *migrationSize)
+		return
+	}
+
+	selection, err := newSelection(*selectionName)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	crossover, err := newBitCrossover(*crossoverName)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	mutation, err := newBitMutation(*mutationName)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	problem, err := newProblem(*problemName, crossover, mutation)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	operators := fmt.Sprintf("%s-%s", crossover.Name(), mutation.Name())
+	if problem.Name() != "onemax" {
+		operators = "fixed-fixed"
+	}
+	label := fmt.Sprintf("%s-%s-%s", problem.Name(), selection.Name(), operators)
+
+	rand.Seed(time.Now().UnixNano())
+
+	if *islands > 1 {
+		islandLabel := fmt.Sprintf("%s-islands%d", label, *islands)
+		runTests(islandLabel, func() RunResult {
+			return runIslandGA(problem, selection, *islands, *migrationInterval, *migrationSize)
+		}, 25, *outputPath, *outputFormat)
+		return
+	}
+
+	if *sweepWorkers {
+		for w := 1; w <= runtime.NumCPU(); w++ {
+			workerLabel := fmt.Sprintf("%s-workers%d", label, w)
+			runTests(workerLabel, func() RunResult { return runGA(problem, selection, w) }, 25, perWorkerOutputPath(*outputPath, w), *outputFormat)
+		}
+		return
+	}
+
+	runTests(label, func() RunResult { return runGA(problem, selection, *workers) }, 25, *outputPath, *outputFormat)
+}
+
+func main() {
+	subcommand := "ga"
+	args := os.Args[1:]
+
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		if args[0] != "ga" && args[0] != "de" {
+			fmt.Printf("unknown subcommand %q (want ga or de)\n", args[0])
+			os.Exit(1)
+		}
+		subcommand = args[0]
+		args = args[1:]
+	}
+
+	switch subcommand {
+	case "ga":
+		runGACommand(args)
+	case "de":
+		runDECommand(args)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// BitMutation flips bits in a bitstring individual. Like BitCrossover,
+// it's specific to OneMax's fixed-length 0/1 encoding.
+type BitMutation interface {
+	Name() string
+	Mutate(rng *rand.Rand, ind Individual) Individual
+}
+
+// BernoulliBitMutation flips each bit independently with probability
+// MutationRate.
+type BernoulliBitMutation struct{}
+
+func (m *BernoulliBitMutation) Name() string {
+	return "bernoulli"
+}
+
+func (m *BernoulliBitMutation) Mutate(rng *rand.Rand, ind Individual) Individual {
+	mutated := cloneIndividual(ind)
+
+	for i := range mutated {
+		if rng.Float64() < MutationRate {
+			mutated[i] = 1 - mutated[i]
+		}
+	}
+
+	return mutated
+}
+
+// ReservoirBitMutation flips exactly k = round(MutationRate * len(ind))
+// bits, chosen as k unique positions via reservoir sampling (Floyd's
+// algorithm) instead of rolling a random number for every bit. At low
+// mutation rates this is far cheaper: it costs O(k) draws rather than
+// O(n) Bernoulli trials.
+type ReservoirBitMutation struct{}
+
+func (m *ReservoirBitMutation) Name() string {
+	return "reservoir"
+}
+
+func (m *ReservoirBitMutation) Mutate(rng *rand.Rand, ind Individual) Individual {
+	mutated := cloneIndividual(ind)
+
+	n := len(mutated)
+	k := int(math.Round(MutationRate * float64(n)))
+	if k <= 0 {
+		return mutated
+	}
+	if k > n {
+		k = n
+	}
+
+	for _, idx := range reservoirSampleIndices(rng, n, k) {
+		mutated[idx] = 1 - mutated[idx]
+	}
+
+	return mutated
+}
+
+// reservoirSampleIndices returns k indices chosen uniformly at random
+// without replacement from [0, n), using Floyd's algorithm: it visits
+// only k candidates rather than scanning all n.
+func reservoirSampleIndices(rng *rand.Rand, n, k int) []int {
+	selected := make(map[int]bool, k)
+	result := make([]int, 0, k)
+
+	for i := n - k; i < n; i++ {
+		t := rng.Intn(i + 1)
+		if selected[t] {
+			t = i
+		}
+		selected[t] = true
+		result = append(result, t)
+	}
+
+	return result
+}
+
+func newBitMutation(name string) (BitMutation, error) {
+	switch name {
+	case "bernoulli":
+		return &BernoulliBitMutation{}, nil
+	case "reservoir":
+		return &ReservoirBitMutation{}, nil
+	default:
+		return nil, fmt.Errorf("unknown mutation strategy %q (want bernoulli or reservoir)", name)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import "math/rand"
+
+// NQueens searches for a permutation of queen placements, one per
+// column, such that no two queens share a row or diagonal. Fitness
+// counts the number of non-attacking queen pairs, so the maximum
+// (a full solution) is N*(N-1)/2.
+type NQueens struct {
+	N int
+}
+
+func NewNQueens(n int) *NQueens {
+	return &NQueens{N: n}
+}
+
+func (p *NQueens) Name() string {
+	return "nqueens"
+}
+
+// maxPairs is the fitness of a fully non-attacking arrangement.
+func (p *NQueens) maxPairs() float64 {
+	return float64(p.N*(p.N-1)) / 2
+}
+
+func (p *NQueens) NewIndividual(rng *rand.Rand) Individual {
+	perm := rng.Perm(p.N)
+	ind := make(Individual, p.N)
+	for i, row := range perm {
+		ind[i] = float64(row)
+	}
+	return ind
+}
+
+// Fitness counts non-attacking pairs. Gene x holds the row of the
+// queen in column x; rows, forward diagonals (y+n-x-1), and backward
+// diagonals (2n-x-y-2) are each tallied in a counting array, and any
+// bucket with more than one queen contributes attacking pairs.
+func (p *NQueens) Fitness(ind Individual) float64 {
+	n := p.N
+	rowCount := make([]int, n)
+	forwardDiag := make([]int, 2*n-1)
+	backwardDiag := make([]int, 2*n-1)
+
+	for x, v := range ind {
+		y := int(v)
+		rowCount[y]++
+		forwardDiag[y+n-x-1]++
+		backwardDiag[2*n-x-y-2]++
+	}
+
+	attacking := 0
+	for _, c := range rowCount {
+		attacking += c * (c - 1) / 2
+	}
+	for _, c := range forwardDiag {
+		attacking += c * (c - 1) / 2
+	}
+	for _, c := range backwardDiag {
+		attacking += c * (c - 1) / 2
+	}
+
+	return p.maxPairs() - float64(attacking)
+}
+
+func (p *NQueens) IsSolved(fitness float64) bool {
+	return fitness >= p.maxPairs()
+}
+
+// Crossover performs order crossover (OX1): a contiguous slice from
+// one parent is copied verbatim, and the remaining positions are
+// filled from the other parent in its own relative order, so both
+// children stay valid permutations.
+func (p *NQueens) Crossover(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual) {
+	if rng.Float64() > CrossoverRate {
+		child1 := make(Individual, len(parent1))
+		child2 := make(Individual, len(parent2))
+		copy(child1, parent1)
+		copy(child2, parent2)
+		return child1, child2
+	}
+
+	n := p.N
+	a := rng.Intn(n)
+	b := rng.Intn(n)
+	if a > b {
+		a, b = b, a
+	}
+
+	child1 := orderCrossoverChild(parent1, parent2, a, b)
+	child2 := orderCrossoverChild(parent2, parent1, a, b)
+
+	return child1, child2
+}
+
+func orderCrossoverChild(primary, secondary Individual, a, b int) Individual {
+	n := len(primary)
+	child := make(Individual, n)
+	used := make(map[float64]bool, n)
+
+	for i := a; i <= b; i++ {
+		child[i] = primary[i]
+		used[primary[i]] = true
+	}
+
+	pos := (b + 1) % n
+	for i := 0; i < n; i++ {
+		gene := secondary[(b+1+i)%n]
+		if used[gene] {
+			continue
+		}
+		child[pos] = gene
+		used[gene] = true
+		pos = (pos + 1) % n
+	}
+
+	return child
+}
+
+// Mutate applies swap mutation: with probability MutationRate, two
+// positions in the permutation are exchanged, which always yields a
+// valid permutation.
+func (p *NQueens) Mutate(rng *rand.Rand, ind Individual) Individual {
+	mutated := make(Individual, len(ind))
+	copy(mutated, ind)
+
+	if rng.Float64() < MutationRate {
+		i := rng.Intn(len(mutated))
+		j := rng.Intn(len(mutated))
+		mutated[i], mutated[j] = mutated[j], mutated[i]
+	}
+
+	return mutated
+}
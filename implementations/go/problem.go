@@ -0,0 +1,73 @@
+package main
+
+import "math/rand"
+
+// Individual is a genome represented as a slice of float64 values.
+// Each Problem interprets the values according to its own encoding:
+// OneMax treats them as bits, NQueens as a permutation, and Ackley
+// as a real-valued vector.
+type Individual []float64
+
+// Problem decouples the GA loop in runGA from any particular fitness
+// landscape. Implementations supply their own genome encoding,
+// fitness function, and genetic operators so the same selection and
+// generation loop can optimize any of them.
+// NewIndividual, Crossover, and Mutate take an explicit *rand.Rand
+// rather than drawing from the global math/rand source, so callers
+// running many of these concurrently (one rng per worker) don't
+// contend on a single mutex-guarded source.
+type Problem interface {
+	Name() string
+	NewIndividual(rng *rand.Rand) Individual
+	Fitness(ind Individual) float64
+	IsSolved(fitness float64) bool
+	Crossover(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual)
+	Mutate(rng *rand.Rand, ind Individual) Individual
+}
+
+// OneMax is the classic bitstring-maximization problem: maximize the
+// number of 1 bits in a fixed-length chromosome. Its crossover and
+// mutation are pluggable BitCrossover/BitMutation strategies, since a
+// bitstring encoding admits several interchangeable operators; NQueens
+// and Ackley instead hard-wire operators suited to their own encoding.
+type OneMax struct {
+	Length    int
+	crossover BitCrossover
+	mutation  BitMutation
+}
+
+func NewOneMax(length int, crossover BitCrossover, mutation BitMutation) *OneMax {
+	return &OneMax{Length: length, crossover: crossover, mutation: mutation}
+}
+
+func (p *OneMax) Name() string {
+	return "onemax"
+}
+
+func (p *OneMax) NewIndividual(rng *rand.Rand) Individual {
+	ind := make(Individual, p.Length)
+	for i := range ind {
+		ind[i] = float64(rng.Intn(2))
+	}
+	return ind
+}
+
+func (p *OneMax) Fitness(ind Individual) float64 {
+	sum := 0.0
+	for _, bit := range ind {
+		sum += bit
+	}
+	return sum
+}
+
+func (p *OneMax) IsSolved(fitness float64) bool {
+	return fitness >= float64(p.Length)
+}
+
+func (p *OneMax) Crossover(rng *rand.Rand, parent1, parent2 Individual) (Individual, Individual) {
+	return p.crossover.Cross(rng, parent1, parent2)
+}
+
+func (p *OneMax) Mutate(rng *rand.Rand, ind Individual) Individual {
+	return p.mutation.Mutate(rng, ind)
+}
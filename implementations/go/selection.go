@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// Selection picks one parent from population according to fitnesses.
+// Unlike Crossover and Mutate, selection only looks at fitness values
+// so the same strategies apply regardless of a problem's genome
+// encoding.
+type Selection interface {
+	Name() string
+	Select(rng *rand.Rand, population []Individual, fitnesses []float64) Individual
+}
+
+func cloneIndividual(ind Individual) Individual {
+	result := make(Individual, len(ind))
+	copy(result, ind)
+	return result
+}
+
+// TournamentSelection holds a Size-way tournament among random
+// individuals and returns the fittest.
+type TournamentSelection struct {
+	Size int
+}
+
+func NewTournamentSelection(size int) *TournamentSelection {
+	return &TournamentSelection{Size: size}
+}
+
+func (s *TournamentSelection) Name() string {
+	return "tournament"
+}
+
+func (s *TournamentSelection) Select(rng *rand.Rand, population []Individual, fitnesses []float64) Individual {
+	indices := make([]int, s.Size)
+	for i := range indices {
+		indices[i] = rng.Intn(len(population))
+	}
+
+	best := indices[0]
+	bestFitness := fitnesses[best]
+
+	for _, idx := range indices[1:] {
+		if fitnesses[idx] > bestFitness {
+			best = idx
+			bestFitness = fitnesses[idx]
+		}
+	}
+
+	return cloneIndividual(population[best])
+}
+
+// RouletteWheelSelection picks an individual with probability
+// proportional to its fitness.
+type RouletteWheelSelection struct{}
+
+func (s *RouletteWheelSelection) Name() string {
+	return "roulette"
+}
+
+// Select shifts fitnesses by the population minimum before building the
+// wheel, so problems like ackley (fitness <= 0 everywhere but the
+// unreachable optimum) still get fitness-proportionate selection
+// instead of silently degrading to uniform random every generation.
+func (s *RouletteWheelSelection) Select(rng *rand.Rand, population []Individual, fitnesses []float64) Individual {
+	min := fitnesses[0]
+	for _, f := range fitnesses[1:] {
+		if f < min {
+			min = f
+		}
+	}
+
+	shifted := make([]float64, len(fitnesses))
+	total := 0.0
+	for i, f := range fitnesses {
+		shifted[i] = f - min
+		total += shifted[i]
+	}
+
+	if total <= 0 {
+		// every individual has identical fitness; any pick is equally
+		// fitness-proportionate.
+		return cloneIndividual(population[rng.Intn(len(population))])
+	}
+
+	pick := rng.Float64() * total
+	cumulative := 0.0
+	for i, f := range shifted {
+		cumulative += f
+		if cumulative >= pick {
+			return cloneIndividual(population[i])
+		}
+	}
+
+	return cloneIndividual(population[len(population)-1])
+}
+
+// RankSelection picks an individual with probability proportional to
+// its fitness rank (1 for worst, len(population) for best) rather
+// than its raw fitness, which keeps selection pressure steady even
+// when fitness values are close together or wildly skewed.
+type RankSelection struct{}
+
+func (s *RankSelection) Name() string {
+	return "rank"
+}
+
+func (s *RankSelection) Select(rng *rand.Rand, population []Individual, fitnesses []float64) Individual {
+	n := len(population)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return fitnesses[order[a]] < fitnesses[order[b]]
+	})
+
+	totalWeight := n * (n + 1) / 2
+	pick := rng.Intn(totalWeight) + 1
+
+	cumulative := 0
+	for rank, idx := range order {
+		cumulative += rank + 1
+		if cumulative >= pick {
+			return cloneIndividual(population[idx])
+		}
+	}
+
+	return cloneIndividual(population[order[n-1]])
+}
+
+func newSelection(name string) (Selection, error) {
+	switch name {
+	case "tournament":
+		return NewTournamentSelection(3), nil
+	case "roulette":
+		return &RouletteWheelSelection{}, nil
+	case "rank":
+		return &RankSelection{}, nil
+	default:
+		return nil, fmt.Errorf("unknown selection strategy %q (want tournament, roulette, or rank)", name)
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
+)
+
+// GenerationStat captures one generation's fitness spread, population
+// diversity, and wall-time, so a full run can be replayed or plotted
+// afterward instead of only knowing its final result.
+type GenerationStat struct {
+	Generation int           `json:"generation"`
+	Best       float64       `json:"best"`
+	Mean       float64       `json:"mean"`
+	Worst      float64       `json:"worst"`
+	Diversity  float64       `json:"diversity"`
+	WallTime   time.Duration `json:"wall_time_ns"`
+}
+
+// RunResult is what a single call to runGA, runDE, or runIslandGA
+// returns: the generation it stopped at, the best fitness found, how
+// long the whole run took, and (where tracked) its per-generation
+// history.
+type RunResult struct {
+	Generations int              `json:"generations"`
+	BestFitness float64          `json:"best_fitness"`
+	WallTime    time.Duration    `json:"wall_time_ns"`
+	History     []GenerationStat `json:"history,omitempty"`
+}
+
+// RunSummary aggregates wall-clock time across the repeated runs a
+// benchmark performs, so results can be compared by more than a raw
+// list of per-run milliseconds.
+type RunSummary struct {
+	Label    string      `json:"label"`
+	Runs     []RunResult `json:"runs"`
+	MeanMs   float64     `json:"mean_ms"`
+	StdDevMs float64     `json:"stddev_ms"`
+	MinMs    float64     `json:"min_ms"`
+	MaxMs    float64     `json:"max_ms"`
+}
+
+func meanOf(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func worstFitnessOf(fitnesses []float64) float64 {
+	worst := fitnesses[0]
+	for _, f := range fitnesses[1:] {
+		if f < worst {
+			worst = f
+		}
+	}
+	return worst
+}
+
+func stdDevOf(values []float64, mean float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	sumSq := 0.0
+	for _, v := range values {
+		d := v - mean
+		sumSq += d * d
+	}
+	return math.Sqrt(sumSq / float64(len(values)-1))
+}
+
+func minOf(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+func maxOf(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+func runResultMs(r RunResult) float64 {
+	return float64(r.WallTime.Microseconds()) / 1000.0
+}
+
+func summarizeRuns(label string, runs []RunResult) RunSummary {
+	times := make([]float64, len(runs))
+	for i, r := range runs {
+		times[i] = runResultMs(r)
+	}
+	mean := meanOf(times)
+
+	return RunSummary{
+		Label:    label,
+		Runs:     runs,
+		MeanMs:   mean,
+		StdDevMs: stdDevOf(times, mean),
+		MinMs:    minOf(times),
+		MaxMs:    maxOf(times),
+	}
+}
+
+// hammingDistance counts positions where two genomes differ. It's
+// exact for OneMax's bits and NQueens' permutations; for Ackley's
+// real-valued genome it mostly just reports "all positions differ"
+// since two independent floats are almost never bit-identical, so
+// diversity there is a cruder signal than for the discrete problems.
+func hammingDistance(a, b Individual) int {
+	count := 0
+	for i := range a {
+		if a[i] != b[i] {
+			count++
+		}
+	}
+	return count
+}
+
+const diversitySampleSize = 10
+
+// sampledDiversity estimates population diversity as the mean Hamming
+// distance between pairs drawn from a random sample, avoiding an
+// O(populationSize^2) comparison every generation.
+func sampledDiversity(rng *rand.Rand, population []Individual) float64 {
+	sampleSize := min(diversitySampleSize, len(population))
+	if sampleSize < 2 {
+		return 0
+	}
+
+	indices := reservoirSampleIndices(rng, len(population), sampleSize)
+
+	total := 0.0
+	pairs := 0
+	for i := 0; i < sampleSize; i++ {
+		for j := i + 1; j < sampleSize; j++ {
+			total += float64(hammingDistance(population[indices[i]], population[indices[j]]))
+			pairs++
+		}
+	}
+
+	return total / float64(pairs)
+}
+
+func writeStatsFile(path, format string, summary RunSummary) error {
+	switch format {
+	case "csv":
+		return writeStatsCSV(path, summary)
+	case "json":
+		return writeStatsJSON(path, summary)
+	default:
+		return fmt.Errorf("unknown output format %q (want csv or json)", format)
+	}
+}
+
+func writeStatsJSON(path string, summary RunSummary) error {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// writeStatsCSV flattens every run's per-generation history into one
+// row-per-generation CSV, tagged with its run index.
+func writeStatsCSV(path string, summary RunSummary) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	if err := w.Write([]string{"run", "generation", "best", "mean", "worst", "diversity", "wall_time_ms"}); err != nil {
+		return err
+	}
+
+	for runIdx, run := range summary.Runs {
+		for _, gen := range run.History {
+			record := []string{
+				strconv.Itoa(runIdx),
+				strconv.Itoa(gen.Generation),
+				strconv.FormatFloat(gen.Best, 'f', 6, 64),
+				strconv.FormatFloat(gen.Mean, 'f', 6, 64),
+				strconv.FormatFloat(gen.Worst, 'f', 6, 64),
+				strconv.FormatFloat(gen.Diversity, 'f', 6, 64),
+				strconv.FormatFloat(float64(gen.WallTime.Microseconds())/1000.0, 'f', 6, 64),
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.Error()
+}